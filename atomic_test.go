@@ -0,0 +1,27 @@
+package counter
+
+import "testing"
+
+// BenchmarkCyclicIncrementorMutex - baseline throughput of the mutex-based implementation
+// under high writer contention.
+func BenchmarkCyclicIncrementorMutex(b *testing.B) {
+	c, _ := NewCyclicIncrementor()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			c.Inc()
+		}
+	})
+}
+
+// BenchmarkAtomicCyclicIncrementor - throughput of the lock-free implementation under the
+// same high writer contention as BenchmarkCyclicIncrementorMutex.
+func BenchmarkAtomicCyclicIncrementor(b *testing.B) {
+	c, _ := NewAtomicCyclicIncrementor()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			c.Inc()
+		}
+	})
+}
@@ -0,0 +1,149 @@
+package counter
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ErrBoundedCounterBusy - returned by BoundedCounter.SetMaxValue when the requested maximum
+// is lower than the number of currently acquired permits.
+var ErrBoundedCounterBusy = errors.New("counter.BoundedCounter: cannot shrink below current in-flight count")
+
+// BoundedCounter - counting semaphore built on the same "max value" concept as CyclicIncrementor,
+// but instead of wrapping around to zero, Acquire() blocks (respecting context cancellation)
+// once the value reaches max, and Release() decrements it and wakes a waiter.
+// This is the pattern used by connection-limiting listeners to enforce a fixed cap on
+// in-flight work.
+//
+// Use NewBoundedCounter(max) to create counter, but also can create counter like this:
+//	c := &counter.BoundedCounter{}
+// In that case, counter is not operational (Acquire always blocks) until its maximum value
+// will be set:
+//	c.SetMaxValue(max)
+type BoundedCounter struct {
+	mx      sync.Mutex // for value, max and waiters
+	value   int
+	max     int
+	waiters list.List // of chan struct{}
+}
+
+// notifyWaiters - wake as many front waiters as the current max allows. Must be called with mx held.
+func (c *BoundedCounter) notifyWaiters() {
+	for {
+		front := c.waiters.Front()
+		if front == nil || c.value >= c.max {
+			return
+		}
+		c.value++
+		ready := front.Value.(chan struct{})
+		close(ready)
+		c.waiters.Remove(front)
+	}
+}
+
+// GetValue - return number of currently acquired permits.
+func (c *BoundedCounter) GetValue() int {
+	if c == nil {
+		return 0
+	}
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	return c.value
+}
+
+// TryAcquire - acquire a permit without blocking. Returns false if the counter is already at max.
+func (c *BoundedCounter) TryAcquire() bool {
+	if c == nil {
+		return false
+	}
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	if c.value < c.max && c.waiters.Len() == 0 {
+		c.value++
+		return true
+	}
+	return false
+}
+
+// Acquire - increment the counter, blocking until a permit is available or ctx is done.
+func (c *BoundedCounter) Acquire(ctx context.Context) error {
+	if c == nil {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	c.mx.Lock()
+	if c.value < c.max && c.waiters.Len() == 0 {
+		c.value++
+		c.mx.Unlock()
+		return nil
+	}
+	ready := make(chan struct{})
+	elem := c.waiters.PushBack(ready)
+	c.mx.Unlock()
+
+	select {
+	case <-ctx.Done():
+		err := ctx.Err()
+		c.mx.Lock()
+		select {
+		case <-ready:
+			// acquired concurrently with cancellation, honor the permit
+			err = nil
+		default:
+			isFront := c.waiters.Front() == elem
+			c.waiters.Remove(elem)
+			if isFront {
+				c.notifyWaiters()
+			}
+		}
+		c.mx.Unlock()
+		return err
+	case <-ready:
+		return nil
+	}
+}
+
+// Release - decrement the counter and wake a waiter, if any.
+func (c *BoundedCounter) Release() {
+	if c == nil {
+		return
+	}
+	c.mx.Lock()
+	if c.value > 0 {
+		c.value--
+	}
+	c.notifyWaiters()
+	c.mx.Unlock()
+}
+
+// SetMaxValue - change max allowed value for counter. Returns ErrBoundedCounterBusy if max is
+// lower than the current in-flight count.
+func (c *BoundedCounter) SetMaxValue(max int) error {
+	if max < 0 {
+		return fmt.Errorf("counter.BoundedCounter: invalid max value (%d)", max)
+	}
+	if c == nil {
+		return nil
+	}
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	if max < c.value {
+		return ErrBoundedCounterBusy
+	}
+	c.max = max
+	c.notifyWaiters()
+	return nil
+}
+
+// NewBoundedCounter - return new bounded counter (semaphore) with given maximum number of
+// in-flight permits.
+func NewBoundedCounter(max int) (*BoundedCounter, error) {
+	c := &BoundedCounter{}
+	if err := c.SetMaxValue(max); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
@@ -0,0 +1,131 @@
+package counter
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestBoundedCounter(t *testing.T) {
+	c, err := NewBoundedCounter(2)
+	if err != nil {
+		t.Fatalf("Unexpected initial error: %s", err.Error())
+	}
+
+	if !c.TryAcquire() || !c.TryAcquire() {
+		t.Fatalf("Expected to acquire both permits")
+	}
+	if c.TryAcquire() {
+		t.Fatalf("Expected TryAcquire to fail once at max")
+	}
+	if c.GetValue() != 2 {
+		t.Errorf("Unexpected counter value (%d)", c.GetValue())
+	}
+
+	c.Release()
+	if c.GetValue() != 1 {
+		t.Errorf("Unexpected counter value after Release (%d)", c.GetValue())
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := c.Acquire(ctx); err != nil {
+		t.Errorf("Unexpected Acquire error: %s", err.Error())
+	}
+	if c.GetValue() != 2 {
+		t.Errorf("Unexpected counter value after Acquire (%d)", c.GetValue())
+	}
+}
+
+func TestBoundedCounterSetMaxValueBusy(t *testing.T) {
+	c, _ := NewBoundedCounter(2)
+	c.TryAcquire()
+	c.TryAcquire()
+
+	if err := c.SetMaxValue(1); !errors.Is(err, ErrBoundedCounterBusy) {
+		t.Errorf("Expected ErrBoundedCounterBusy, got: %v", err)
+	}
+	if err := c.SetMaxValue(2); err != nil {
+		t.Errorf("Unexpected error shrinking to current in-flight count: %s", err.Error())
+	}
+}
+
+func TestBoundedCounterAcquireContextCancellation(t *testing.T) {
+	c, _ := NewBoundedCounter(1)
+	if !c.TryAcquire() {
+		t.Fatalf("Expected to acquire the only permit")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err := c.Acquire(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected context.DeadlineExceeded, got: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Errorf("Acquire returned too early (%s)", elapsed)
+	}
+	if c.GetValue() != 1 {
+		t.Errorf("Unexpected counter value after cancelled Acquire (%d)", c.GetValue())
+	}
+}
+
+func TestBoundedCounterResizeUpReleasesWaiters(t *testing.T) {
+	c, _ := NewBoundedCounter(1)
+	if !c.TryAcquire() {
+		t.Fatalf("Expected to acquire the only permit")
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- c.Acquire(context.Background())
+	}()
+
+	// give the goroutine a chance to start waiting
+	time.Sleep(20 * time.Millisecond)
+
+	if err := c.SetMaxValue(2); err != nil {
+		t.Fatalf("Unexpected SetMaxValue error: %s", err.Error())
+	}
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("Unexpected Acquire error: %s", err.Error())
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("Acquire was not released after resize-up")
+	}
+	if c.GetValue() != 2 {
+		t.Errorf("Unexpected counter value (%d)", c.GetValue())
+	}
+}
+
+func TestBoundedCounterConcurrency(t *testing.T) {
+	c, _ := NewBoundedCounter(3)
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+			if err := c.Acquire(ctx); err != nil {
+				t.Errorf("Unexpected Acquire error: %s", err.Error())
+				return
+			}
+			if c.GetValue() > 3 {
+				t.Errorf("Counter value (%d) exceeded max (3)", c.GetValue())
+			}
+			c.Release()
+		}()
+	}
+	wg.Wait()
+	if c.GetValue() != 0 {
+		t.Errorf("Unexpected counter value after all releases (%d)", c.GetValue())
+	}
+}
@@ -20,9 +20,21 @@ const (
 //	var c *CyclicIncrementor
 // and is not really initialized, it silently ignores all calls for it methods without errors and cannot be used.
 type CyclicIncrementor struct {
-	mx    sync.RWMutex // for value and max
-	value int
-	max   int
+	mx         sync.RWMutex // for value and max
+	value      int
+	max        int
+	cycleCount uint64 // number of times Inc() wrapped value back to zero, guarded by mx
+
+	subMx        sync.Mutex // for rolloverSubs, onValueSubs and nextSubID
+	nextSubID    int
+	rolloverSubs map[int]func(cycleCount uint64)
+	onValueSubs  map[int]onValueSubscription
+}
+
+// onValueSubscription - one-shot OnValue() subscription.
+type onValueSubscription struct {
+	target int
+	fn     func()
 }
 
 // GetValue - return counter value
@@ -36,17 +48,25 @@ func (c *CyclicIncrementor) GetValue() int {
 }
 
 // Inc - increment by 1 current value of counter. When value is reached max, counter will reset into zero.
+// Subscribers registered with OnRollover are notified when counter rolls over, and those
+// registered with OnValue when the new value equals their target, outside of the counter's write lock.
 func (c *CyclicIncrementor) Inc() {
 	if c == nil {
 		return
 	}
 	c.mx.Lock()
+	rolledOver := false
 	if c.value < c.max {
 		c.value++
 	} else {
 		c.value = 0
+		rolledOver = true
+		c.cycleCount++
 	}
+	value, cycleCount := c.value, c.cycleCount
 	c.mx.Unlock()
+
+	c.notify(rolledOver, value, cycleCount)
 }
 
 // SetMaxValue - change max allowed value for counter.
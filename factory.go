@@ -0,0 +1,162 @@
+package counter
+
+import (
+	"fmt"
+	"sync/atomic"
+)
+
+// Kind - selects which Incrementor implementation New() builds.
+type Kind int
+
+const (
+	// KindCyclic - builds a *CyclicIncrementor.
+	KindCyclic Kind = iota
+	// KindAtomicCyclic - builds a *AtomicCyclicIncrementor.
+	KindAtomicCyclic
+	// KindSaturating - builds a *SaturatingIncrementor.
+	KindSaturating
+	// KindRange - builds a *RangeIncrementor.
+	KindRange
+	// KindStepped - builds a *SteppedIncrementor.
+	KindStepped
+)
+
+// options - collects the settings applied by Option functions passed to New().
+type options struct {
+	max        int
+	min        int
+	step       int
+	initial    int
+	maxSet     bool
+	minSet     bool
+	stepSet    bool
+	initialSet bool
+}
+
+// Option - functional option configuring New().
+type Option func(*options)
+
+// WithMax - set the maximum allowed value for the built Incrementor. Defaults to MaxInt.
+func WithMax(max int) Option {
+	return func(o *options) {
+		o.max = max
+		o.maxSet = true
+	}
+}
+
+// WithMin - set the minimum allowed value for the built Incrementor. Only meaningful for
+// KindRange, where it defaults to 0.
+func WithMin(min int) Option {
+	return func(o *options) {
+		o.min = min
+		o.minSet = true
+	}
+}
+
+// WithStep - set the increment applied on every Inc() call. Only meaningful for KindStepped,
+// where it defaults to 1.
+func WithStep(step int) Option {
+	return func(o *options) {
+		o.step = step
+		o.stepSet = true
+	}
+}
+
+// WithInitialValue - set the counter's initial value. Defaults to 0.
+func WithInitialValue(value int) Option {
+	return func(o *options) {
+		o.initial = value
+		o.initialSet = true
+	}
+}
+
+// New - build an Incrementor of the requested kind, configured by opts.
+func New(kind Kind, opts ...Option) (Incrementor, error) {
+	o := options{max: MaxInt, step: 1}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	switch kind {
+	case KindCyclic:
+		c := &CyclicIncrementor{}
+		if err := c.SetMaxValue(o.max); err != nil {
+			return nil, err
+		}
+		if o.initialSet {
+			if err := validateInitialValue(o.initial, 0, o.max); err != nil {
+				return nil, err
+			}
+			c.value = o.initial
+		}
+		return c, nil
+	case KindAtomicCyclic:
+		c := &AtomicCyclicIncrementor{}
+		if err := c.SetMaxValue(o.max); err != nil {
+			return nil, err
+		}
+		if o.initialSet {
+			if err := validateInitialValue(o.initial, 0, o.max); err != nil {
+				return nil, err
+			}
+			atomic.StoreInt64(&c.value, int64(o.initial))
+		}
+		return c, nil
+	case KindSaturating:
+		c := &SaturatingIncrementor{}
+		if err := c.SetMaxValue(o.max); err != nil {
+			return nil, err
+		}
+		if o.initialSet {
+			if err := validateInitialValue(o.initial, 0, o.max); err != nil {
+				return nil, err
+			}
+			c.value = o.initial
+		}
+		return c, nil
+	case KindRange:
+		c := &RangeIncrementor{}
+		if err := c.SetMaxValue(o.max); err != nil {
+			return nil, err
+		}
+		if o.minSet {
+			if err := c.SetMinValue(o.min); err != nil {
+				return nil, err
+			}
+		}
+		if o.initialSet {
+			if err := validateInitialValue(o.initial, o.min, o.max); err != nil {
+				return nil, err
+			}
+			c.value = o.initial
+		}
+		return c, nil
+	case KindStepped:
+		c := &SteppedIncrementor{}
+		if err := c.SetMaxValue(o.max); err != nil {
+			return nil, err
+		}
+		if err := c.SetStep(o.step); err != nil {
+			return nil, err
+		}
+		if o.initialSet {
+			if err := validateInitialValue(o.initial, 0, o.max); err != nil {
+				return nil, err
+			}
+			c.value = o.initial
+		}
+		return c, nil
+	default:
+		return nil, fmt.Errorf("counter: unknown Incrementor kind (%d)", kind)
+	}
+}
+
+// validateInitialValue - check that an explicit WithInitialValue() falls within the
+// configured [min, max] bounds, so a built Incrementor never starts out already violating the
+// invariant its own Inc()/SetMaxValue() enforce.
+func validateInitialValue(initial, min, max int) error {
+	if initial < min || initial > max {
+		return fmt.Errorf("counter: invalid initial value (%d), must be within [%d, %d]", initial, min, max)
+	}
+	return nil
+}
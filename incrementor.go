@@ -0,0 +1,12 @@
+package counter
+
+// Incrementor - common behavior shared by all counter implementations in this package.
+type Incrementor interface {
+	// GetValue - return counter value
+	GetValue() int
+	// Inc - advance current value of counter; the step size and what happens at max depend on
+	// the implementation
+	Inc()
+	// SetMaxValue - change max allowed value for counter
+	SetMaxValue(max int) error
+}
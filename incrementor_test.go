@@ -0,0 +1,296 @@
+package counter
+
+import (
+	"sync"
+	"testing"
+)
+
+// incrementorCase describes one Incrementor implementation under the invariants shared by
+// all of them: a known starting value, and how Inc() transforms the previous value.
+type incrementorCase struct {
+	name  string
+	build func() (Incrementor, error)
+	start int
+	next  func(prev int) int
+}
+
+func incrementorCases() []incrementorCase {
+	return []incrementorCase{
+		{
+			name:  "CyclicIncrementor",
+			build: func() (Incrementor, error) { return New(KindCyclic, WithMax(4)) },
+			start: 0,
+			next: func(prev int) int {
+				if prev < 4 {
+					return prev + 1
+				}
+				return 0
+			},
+		},
+		{
+			name:  "AtomicCyclicIncrementor",
+			build: func() (Incrementor, error) { return New(KindAtomicCyclic, WithMax(4)) },
+			start: 0,
+			next: func(prev int) int {
+				if prev < 4 {
+					return prev + 1
+				}
+				return 0
+			},
+		},
+		{
+			name:  "SaturatingIncrementor",
+			build: func() (Incrementor, error) { return New(KindSaturating, WithMax(4)) },
+			start: 0,
+			next: func(prev int) int {
+				if prev < 4 {
+					return prev + 1
+				}
+				return 4
+			},
+		},
+		{
+			name:  "RangeIncrementor",
+			build: func() (Incrementor, error) { return New(KindRange, WithMin(2), WithMax(6)) },
+			start: 2,
+			next: func(prev int) int {
+				if prev < 6 {
+					return prev + 1
+				}
+				return 2
+			},
+		},
+		{
+			name:  "SteppedIncrementor",
+			build: func() (Incrementor, error) { return New(KindStepped, WithMax(5), WithStep(2)) },
+			start: 0,
+			next: func(prev int) int {
+				if prev+2 <= 5 {
+					return prev + 2
+				}
+				return 0
+			},
+		},
+	}
+}
+
+// TestIncrementors - exercises every Incrementor implementation against the Inc() invariant
+// that applies to it: what happens to the value once max is reached.
+func TestIncrementors(t *testing.T) {
+	for _, tc := range incrementorCases() {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			c, err := tc.build()
+			if err != nil {
+				t.Fatalf("Unexpected build error: %s", err.Error())
+			}
+			if c.GetValue() != tc.start {
+				t.Fatalf("Unexpected initial value (%d), expected (%d)", c.GetValue(), tc.start)
+			}
+			want := tc.start
+			for i := 0; i < 20; i++ {
+				c.Inc()
+				want = tc.next(want)
+				if c.GetValue() != want {
+					t.Fatalf("After Inc() #%d: unexpected value (%d), expected (%d)", i+1, c.GetValue(), want)
+				}
+			}
+		})
+	}
+}
+
+// TestIncrementorsLoweringMaxAdjustsValue - lowering max below the current value must bring the
+// value back in range: wrapping kinds (Cyclic, AtomicCyclic, Range, Stepped) reset to their
+// floor (0, or min for Range), while SaturatingIncrementor clamps down to the new max instead.
+func TestIncrementorsLoweringMaxAdjustsValue(t *testing.T) {
+	cases := []struct {
+		name  string
+		build func() (Incrementor, error)
+		after int
+	}{
+		{"CyclicIncrementor", func() (Incrementor, error) { return New(KindCyclic, WithMax(10)) }, 0},
+		{"AtomicCyclicIncrementor", func() (Incrementor, error) { return New(KindAtomicCyclic, WithMax(10)) }, 0},
+		{"SaturatingIncrementor", func() (Incrementor, error) { return New(KindSaturating, WithMax(10)) }, 4},
+		{"RangeIncrementor", func() (Incrementor, error) { return New(KindRange, WithMax(10)) }, 0},
+		{"SteppedIncrementor", func() (Incrementor, error) { return New(KindStepped, WithMax(10)) }, 0},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			c, err := tc.build()
+			if err != nil {
+				t.Fatalf("Unexpected build error: %s", err.Error())
+			}
+			for i := 0; i < 5; i++ {
+				c.Inc()
+			}
+			if c.GetValue() != 5 {
+				t.Fatalf("Unexpected value (%d) before lowering max", c.GetValue())
+			}
+			if err := c.SetMaxValue(4); err != nil {
+				t.Fatalf("Unexpected SetMaxValue(4) error: %s", err.Error())
+			}
+			if c.GetValue() != tc.after {
+				t.Errorf("Unexpected value (%d) after lowering max, expected (%d)", c.GetValue(), tc.after)
+			}
+		})
+	}
+}
+
+// TestIncrementorsConcurrency - Inc()/GetValue() must be safe to call concurrently (run with
+// -race) for every implementation.
+func TestIncrementorsConcurrency(t *testing.T) {
+	cases := []struct {
+		name string
+		kind Kind
+	}{
+		{"CyclicIncrementor", KindCyclic},
+		{"AtomicCyclicIncrementor", KindAtomicCyclic},
+		{"SaturatingIncrementor", KindSaturating},
+		{"RangeIncrementor", KindRange},
+		{"SteppedIncrementor", KindStepped},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			c, err := New(tc.kind)
+			if err != nil {
+				t.Fatalf("Unexpected build error: %s", err.Error())
+			}
+
+			numWriters := 5
+			numIncrementsPerWriter := 10
+			expectedValue := numWriters * numIncrementsPerWriter
+			wg := sync.WaitGroup{}
+			wg.Add(numWriters * 2)
+			// if race will be detected test will fail
+			// with single core the test will not ever fail
+			for i := 0; i < numWriters; i++ {
+				go func() {
+					for j := 0; j < numIncrementsPerWriter; j++ {
+						c.Inc()
+					}
+					wg.Done()
+				}()
+				// also run concurrent reads
+				go func() {
+					for j := 0; j < 20; j++ {
+						c.GetValue()
+					}
+					wg.Done()
+				}()
+			}
+			wg.Wait()
+
+			if c.GetValue() != expectedValue {
+				t.Errorf("Unexpected counter value (%d)", c.GetValue())
+			}
+		})
+	}
+}
+
+// TestIncrementorsRejectNegativeMax - SetMaxValue(-1) must be rejected by every implementation.
+func TestIncrementorsRejectNegativeMax(t *testing.T) {
+	for _, tc := range incrementorCases() {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			c, err := tc.build()
+			if err != nil {
+				t.Fatalf("Unexpected build error: %s", err.Error())
+			}
+			if err := c.SetMaxValue(-1); err == nil {
+				t.Errorf("SetMaxValue(-1) must return error, but it is not")
+			}
+		})
+	}
+}
+
+// TestIncrementorsNilSafety - GetValue() and SetMaxValue() must not panic on a nil pointer for
+// any implementation, per each type's documented nil-pointer behavior; Inc() must be a no-op.
+func TestIncrementorsNilSafety(t *testing.T) {
+	cases := []struct {
+		name string
+		c    Incrementor
+	}{
+		{"CyclicIncrementor", (*CyclicIncrementor)(nil)},
+		{"AtomicCyclicIncrementor", (*AtomicCyclicIncrementor)(nil)},
+		{"SaturatingIncrementor", (*SaturatingIncrementor)(nil)},
+		{"RangeIncrementor", (*RangeIncrementor)(nil)},
+		{"SteppedIncrementor", (*SteppedIncrementor)(nil)},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			if v := tc.c.GetValue(); v != 0 {
+				t.Errorf("GetValue() on nil returned (%d), expected 0", v)
+			}
+			tc.c.Inc() // must not panic
+			if err := tc.c.SetMaxValue(-1); err == nil {
+				t.Errorf("SetMaxValue(-1) on nil must return error, but it is not")
+			}
+			if err := tc.c.SetMaxValue(1); err != nil {
+				t.Errorf("SetMaxValue(1) on nil must not error, got: %s", err.Error())
+			}
+		})
+	}
+}
+
+func TestNewUnknownKind(t *testing.T) {
+	if _, err := New(Kind(999)); err == nil {
+		t.Errorf("New() with unknown Kind must return error, but it is not")
+	}
+}
+
+func TestNewWithInitialValue(t *testing.T) {
+	c, err := New(KindCyclic, WithMax(10), WithInitialValue(7))
+	if err != nil {
+		t.Fatalf("Unexpected build error: %s", err.Error())
+	}
+	if c.GetValue() != 7 {
+		t.Errorf("Unexpected initial value (%d), expected 7", c.GetValue())
+	}
+
+	if _, err := New(KindCyclic, WithInitialValue(-1)); err == nil {
+		t.Errorf("WithInitialValue(-1) must return error, but it is not")
+	}
+}
+
+func TestNewRangeInvalidBounds(t *testing.T) {
+	if _, err := New(KindRange, WithMin(10), WithMax(5)); err == nil {
+		t.Errorf("New(KindRange) with min > max must return error, but it is not")
+	}
+}
+
+// TestNewRangeMinBeforeMaxIsSet - regression test: a positive WithMin() must not be rejected
+// just because it is validated against a max that New() has not applied yet.
+func TestNewRangeMinBeforeMaxIsSet(t *testing.T) {
+	c, err := New(KindRange, WithMin(2), WithMax(6))
+	if err != nil {
+		t.Fatalf("Unexpected build error: %s", err.Error())
+	}
+	if c.GetValue() != 2 {
+		t.Errorf("Unexpected initial value (%d), expected 2", c.GetValue())
+	}
+}
+
+func TestNewWithInitialValueOutOfBounds(t *testing.T) {
+	cases := []struct {
+		name string
+		opts []Option
+	}{
+		{"CyclicIncrementor", []Option{WithMax(3), WithInitialValue(10)}},
+		{"AtomicCyclicIncrementor", []Option{WithMax(3), WithInitialValue(10)}},
+		{"SaturatingIncrementor", []Option{WithMax(3), WithInitialValue(10)}},
+		{"RangeIncrementor", []Option{WithMin(2), WithMax(6), WithInitialValue(1)}},
+		{"SteppedIncrementor", []Option{WithMax(3), WithInitialValue(10)}},
+	}
+	kinds := []Kind{KindCyclic, KindAtomicCyclic, KindSaturating, KindRange, KindStepped}
+	for i, tc := range cases {
+		tc, kind := tc, kinds[i]
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := New(kind, tc.opts...); err == nil {
+				t.Errorf("New() with out-of-bounds initial value must return error, but it is not")
+			}
+		})
+	}
+}
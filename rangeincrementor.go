@@ -0,0 +1,102 @@
+package counter
+
+import (
+	"fmt"
+	"sync"
+)
+
+// RangeIncrementor - step by step counter bounded on both ends. After maximum is reached
+// counter will reset into its minimum value (instead of always zero, as CyclicIncrementor does).
+//
+// Use NewRangeIncrementor() to create counter, but also can create counter like this:
+//	c := &counter.RangeIncrementor{}
+// In that case, counter is operational right away with the zero-value range (min == max == 0);
+// configure it with (max first, since SetMinValue() rejects a min above the current max)
+//	c.SetMaxValue(max)
+//	c.SetMinValue(min)
+// Also note, if counter is declared as pointer:
+//	var c *RangeIncrementor
+// and is not really initialized, GetValue() returns 0 and SetMinValue()/SetMaxValue() return nil
+// without panicking (SetMaxValue() still returns an error for a negative max); Inc() is a no-op.
+type RangeIncrementor struct {
+	mx    sync.RWMutex // for value, min and max
+	value int
+	min   int
+	max   int
+}
+
+// GetValue - return counter value
+func (c *RangeIncrementor) GetValue() int {
+	if c == nil {
+		return 0
+	}
+	c.mx.RLock()
+	defer c.mx.RUnlock()
+	return c.value
+}
+
+// Inc - increment by 1 current value of counter. When value is reached max, counter will
+// reset into its minimum value.
+func (c *RangeIncrementor) Inc() {
+	if c == nil {
+		return
+	}
+	c.mx.Lock()
+	if c.value < c.max {
+		c.value++
+	} else {
+		c.value = c.min
+	}
+	c.mx.Unlock()
+}
+
+// SetMaxValue - change max allowed value for counter. Returns an error if max is lower than
+// the current minimum.
+func (c *RangeIncrementor) SetMaxValue(max int) error {
+	if max < 0 {
+		return fmt.Errorf("counter.RangeIncrementor: invalid max value (%d)", max)
+	}
+	if c == nil {
+		return nil
+	}
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	if max < c.min {
+		return fmt.Errorf("counter.RangeIncrementor: max value (%d) is lower than min value (%d)", max, c.min)
+	}
+	if c.value > max {
+		c.value = c.min
+	}
+	c.max = max
+	return nil
+}
+
+// SetMinValue - change min allowed value for counter. Returns an error if min is higher than
+// the current maximum.
+func (c *RangeIncrementor) SetMinValue(min int) error {
+	if c == nil {
+		return nil
+	}
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	if min > c.max {
+		return fmt.Errorf("counter.RangeIncrementor: min value (%d) is higher than max value (%d)", min, c.max)
+	}
+	if c.value < min {
+		c.value = min
+	}
+	c.min = min
+	return nil
+}
+
+// NewRangeIncrementor - return new range counter with preassigned minimum value equals to 0
+// and maximum value equals to MaxInt.
+func NewRangeIncrementor() (*RangeIncrementor, error) {
+	c := &RangeIncrementor{}
+	if err := c.SetMaxValue(MaxInt); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+var _ Incrementor = (*RangeIncrementor)(nil)
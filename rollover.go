@@ -0,0 +1,91 @@
+package counter
+
+import "log"
+
+// OnRollover - subscribe fn to be called every time the counter wraps back to zero.
+// fn receives the current cycleCount, i.e. the total number of times the counter has rolled
+// over so far. Returns an unsubscribe function that stops future notifications for this
+// subscriber; calling it more than once is a no-op.
+func (c *CyclicIncrementor) OnRollover(fn func(cycleCount uint64)) (unsubscribe func()) {
+	if c == nil {
+		return func() {}
+	}
+	c.subMx.Lock()
+	if c.rolloverSubs == nil {
+		c.rolloverSubs = make(map[int]func(cycleCount uint64))
+	}
+	id := c.nextSubID
+	c.nextSubID++
+	c.rolloverSubs[id] = fn
+	c.subMx.Unlock()
+
+	return func() {
+		c.subMx.Lock()
+		delete(c.rolloverSubs, id)
+		c.subMx.Unlock()
+	}
+}
+
+// OnValue - subscribe fn to be called once, the next time the counter reaches target.
+// The subscription is removed right after it fires. Returns an unsubscribe function that
+// cancels the subscription if it has not fired yet; calling it more than once is a no-op.
+func (c *CyclicIncrementor) OnValue(target int, fn func()) (unsubscribe func()) {
+	if c == nil {
+		return func() {}
+	}
+	c.subMx.Lock()
+	if c.onValueSubs == nil {
+		c.onValueSubs = make(map[int]onValueSubscription)
+	}
+	id := c.nextSubID
+	c.nextSubID++
+	c.onValueSubs[id] = onValueSubscription{target: target, fn: fn}
+	c.subMx.Unlock()
+
+	return func() {
+		c.subMx.Lock()
+		delete(c.onValueSubs, id)
+		c.subMx.Unlock()
+	}
+}
+
+// notify - collect the subscribers interested in this Inc() outcome and invoke them outside
+// of subMx, so a handler calling back into the counter (e.g. to Inc() or to unsubscribe) never
+// deadlocks.
+func (c *CyclicIncrementor) notify(rolledOver bool, value int, cycleCount uint64) {
+	c.subMx.Lock()
+	var rolloverFns []func(uint64)
+	if rolledOver {
+		for _, fn := range c.rolloverSubs {
+			rolloverFns = append(rolloverFns, fn)
+		}
+	}
+	var valueFns []func()
+	for id, sub := range c.onValueSubs {
+		if sub.target != value {
+			continue
+		}
+		valueFns = append(valueFns, sub.fn)
+		delete(c.onValueSubs, id)
+	}
+	c.subMx.Unlock()
+
+	for _, fn := range rolloverFns {
+		fn := fn
+		safeCall(func() { fn(cycleCount) })
+	}
+	for _, fn := range valueFns {
+		safeCall(fn)
+	}
+}
+
+// safeCall - run fn, recovering and logging a panic so that one misbehaving handler cannot
+// corrupt subsequent notifications.
+func safeCall(fn func()) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("counter: recovered from panic in handler: %v", r)
+		}
+	}()
+	fn()
+}
@@ -0,0 +1,105 @@
+package counter
+
+import (
+	"sync/atomic"
+	"testing"
+)
+
+func TestCyclicIncrementorOnRollover(t *testing.T) {
+	c, _ := NewCyclicIncrementor()
+	max := 3
+	_ = c.SetMaxValue(max)
+
+	var rollovers int64
+	unsubscribe := c.OnRollover(func(cycleCount uint64) {
+		atomic.AddInt64(&rollovers, 1)
+		if cycleCount != uint64(rollovers) {
+			t.Errorf("Unexpected cycleCount (%d), expected (%d)", cycleCount, rollovers)
+		}
+	})
+
+	n := (max + 1) * 5
+	for i := 0; i < n; i++ {
+		c.Inc()
+	}
+	expected := int64(n / (max + 1))
+	if atomic.LoadInt64(&rollovers) != expected {
+		t.Errorf("Unexpected rollover count (%d), expected (%d)", rollovers, expected)
+	}
+
+	unsubscribe()
+	for i := 0; i < max+1; i++ {
+		c.Inc()
+	}
+	if atomic.LoadInt64(&rollovers) != expected {
+		t.Errorf("Rollover callback fired after unsubscribe, count (%d), expected (%d)", rollovers, expected)
+	}
+}
+
+func TestCyclicIncrementorOnValue(t *testing.T) {
+	c, _ := NewCyclicIncrementor()
+	_ = c.SetMaxValue(5)
+
+	var fired int
+	c.OnValue(3, func() {
+		fired++
+	})
+
+	for i := 0; i < 3; i++ {
+		c.Inc()
+	}
+	if fired != 1 {
+		t.Errorf("Unexpected fired count (%d), expected 1", fired)
+	}
+
+	// OnValue is one-shot: reaching the same value again must not fire it a second time.
+	// 4, 5, 0 (rollover), 1, 2, 3
+	for i := 0; i < 6; i++ {
+		c.Inc()
+	}
+	if fired != 1 {
+		t.Errorf("OnValue fired more than once (%d)", fired)
+	}
+}
+
+func TestCyclicIncrementorOnValueUnsubscribe(t *testing.T) {
+	c, _ := NewCyclicIncrementor()
+	_ = c.SetMaxValue(5)
+
+	fired := false
+	unsubscribe := c.OnValue(2, func() {
+		fired = true
+	})
+	unsubscribe()
+
+	for i := 0; i < 2; i++ {
+		c.Inc()
+	}
+	if fired {
+		t.Errorf("OnValue callback fired after unsubscribe")
+	}
+}
+
+func TestCyclicIncrementorHandlerPanicRecovers(t *testing.T) {
+	c, _ := NewCyclicIncrementor()
+	_ = c.SetMaxValue(1)
+
+	c.OnRollover(func(cycleCount uint64) {
+		panic("boom")
+	})
+
+	var afterPanicFired bool
+	c.OnRollover(func(cycleCount uint64) {
+		afterPanicFired = true
+	})
+
+	c.Inc() // value 1
+	c.Inc() // rolls over to 0, both handlers notified
+
+	if !afterPanicFired {
+		t.Errorf("Subsequent handler was not notified after a panicking handler")
+	}
+	if c.GetValue() != 0 {
+		t.Errorf("Unexpected counter value (%d) after panicking handler", c.GetValue())
+	}
+}
@@ -0,0 +1,76 @@
+package counter
+
+import (
+	"fmt"
+	"sync"
+)
+
+// SaturatingIncrementor - step by step counter with limit of its maximum value.
+// Unlike CyclicIncrementor, once maximum is reached, further Inc() calls are no-ops: the
+// counter saturates at max instead of wrapping back to zero.
+//
+// Use NewSaturatingIncrementor() to create counter, but also can create counter like this:
+//	c := &counter.SaturatingIncrementor{}
+// In that case, counter is not operational until its maximum value will be set:
+//	c.SetMaxValue(max)
+// Also note, if counter is declared as pointer:
+//	var c *SaturatingIncrementor
+// and is not really initialized, GetValue() returns 0 and SetMaxValue() returns nil without
+// panicking (or an error for a negative max); Inc() is a no-op.
+type SaturatingIncrementor struct {
+	mx    sync.RWMutex // for value and max
+	value int
+	max   int
+}
+
+// GetValue - return counter value
+func (c *SaturatingIncrementor) GetValue() int {
+	if c == nil {
+		return 0
+	}
+	c.mx.RLock()
+	defer c.mx.RUnlock()
+	return c.value
+}
+
+// Inc - increment by 1 current value of counter. When value has reached max, it stays at max.
+func (c *SaturatingIncrementor) Inc() {
+	if c == nil {
+		return
+	}
+	c.mx.Lock()
+	if c.value < c.max {
+		c.value++
+	}
+	c.mx.Unlock()
+}
+
+// SetMaxValue - change max allowed value for counter. If current value exceeds the new
+// maximum, it is clamped down to it.
+func (c *SaturatingIncrementor) SetMaxValue(max int) error {
+	if max < 0 {
+		return fmt.Errorf("counter.SaturatingIncrementor: invalid max value (%d)", max)
+	}
+	if c == nil {
+		return nil
+	}
+	c.mx.Lock()
+	if c.value > max {
+		c.value = max
+	}
+	c.max = max
+	c.mx.Unlock()
+	return nil
+}
+
+// NewSaturatingIncrementor - return new saturating counter with preassigned maximum value
+// equals to MaxInt.
+func NewSaturatingIncrementor() (*SaturatingIncrementor, error) {
+	c := &SaturatingIncrementor{}
+	if err := c.SetMaxValue(MaxInt); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+var _ Incrementor = (*SaturatingIncrementor)(nil)
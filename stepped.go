@@ -0,0 +1,97 @@
+package counter
+
+import (
+	"fmt"
+	"sync"
+)
+
+// SteppedIncrementor - step by step counter with limit of its maximum value and a configurable
+// increment. After maximum is reached (or would be exceeded) counter will reset into zero,
+// same as CyclicIncrementor, but Inc() advances the value by SetStep() instead of always by 1.
+//
+// Use NewSteppedIncrementor() to create counter, but also can create counter like this:
+//	c := &counter.SteppedIncrementor{}
+// In that case, counter is not operational until its maximum value will be set:
+//	c.SetMaxValue(max)
+// The step defaults to 0 until SetStep() is called, which makes Inc() a no-op until then.
+// Also note, if counter is declared as pointer:
+//	var c *SteppedIncrementor
+// and is not really initialized, GetValue() returns 0 and SetMaxValue()/SetStep() return nil
+// without panicking (or an error for a negative max or a non-positive step); Inc() is a no-op.
+type SteppedIncrementor struct {
+	mx    sync.RWMutex // for value, max and step
+	value int
+	max   int
+	step  int
+}
+
+// GetValue - return counter value
+func (c *SteppedIncrementor) GetValue() int {
+	if c == nil {
+		return 0
+	}
+	c.mx.RLock()
+	defer c.mx.RUnlock()
+	return c.value
+}
+
+// Inc - advance current value of counter by the configured step. When the step would carry
+// value past max, counter will reset into zero instead.
+func (c *SteppedIncrementor) Inc() {
+	if c == nil {
+		return
+	}
+	c.mx.Lock()
+	if c.value+c.step <= c.max {
+		c.value += c.step
+	} else {
+		c.value = 0
+	}
+	c.mx.Unlock()
+}
+
+// SetMaxValue - change max allowed value for counter.
+func (c *SteppedIncrementor) SetMaxValue(max int) error {
+	if max < 0 {
+		return fmt.Errorf("counter.SteppedIncrementor: invalid max value (%d)", max)
+	}
+	if c == nil {
+		return nil
+	}
+	c.mx.Lock()
+	if c.value > max {
+		c.value = 0
+	}
+	c.max = max
+	c.mx.Unlock()
+	return nil
+}
+
+// SetStep - change the increment applied on every Inc() call.
+func (c *SteppedIncrementor) SetStep(step int) error {
+	if step <= 0 {
+		return fmt.Errorf("counter.SteppedIncrementor: invalid step value (%d)", step)
+	}
+	if c == nil {
+		return nil
+	}
+	c.mx.Lock()
+	c.step = step
+	c.mx.Unlock()
+	return nil
+}
+
+// NewSteppedIncrementor - return new stepped counter with preassigned maximum value equals to
+// MaxInt and step equals to 1.
+func NewSteppedIncrementor() (*SteppedIncrementor, error) {
+	c := &SteppedIncrementor{}
+	if err := c.SetMaxValue(MaxInt); err != nil {
+		return nil, err
+	}
+	if err := c.SetStep(1); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+var _ Incrementor = (*SteppedIncrementor)(nil)